@@ -1,28 +1,140 @@
-package api
+package main
 
 import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
 	"github.com/gonzalo-wi/cellcontrol/internal/config"
 	"github.com/gonzalo-wi/cellcontrol/internal/db"
-	httpServer "github.com/gonzalo-wi/cellcontrol/internal/http"
-	"github.com/gonzalo-wi/cellcontrol/internal/http/handlers"
+	"github.com/gonzalo-wi/cellcontrol/internal/domain"
 	"github.com/gonzalo-wi/cellcontrol/internal/repository"
-	"github.com/gonzalo-wi/cellcontrol/internal/service"
+	"github.com/gonzalo-wi/cellcontrol/pkg/di"
 	"github.com/gonzalo-wi/cellcontrol/pkg/logger"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
 )
 
 func main() {
-	logger.Init()
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		runMigrate(os.Args[2:])
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "create-admin" {
+		runCreateAdmin(os.Args[2:])
+		return
+	}
+	runServer()
+}
+
+func runServer() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	container, err := di.New(ctx)
+	if err != nil {
+		panic(err)
+	}
+	defer container.Close()
+
+	errCh := make(chan error, 2)
+	go func() {
+		errCh <- container.Server.Start(ctx)
+	}()
+	go func() {
+		errCh <- container.GRPCServer.Start()
+	}()
+
+	select {
+	case err := <-errCh:
+		if err != nil {
+			logger.Error("error al iniciar servidor", zap.Error(err))
+		}
+	case <-ctx.Done():
+		logger.Info("señal de apagado recibida")
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), container.Config.ShutdownTimeout)
+	defer cancel()
+
+	if err := container.Server.Shutdown(shutdownCtx); err != nil {
+		logger.Error("error al apagar servidor HTTP", zap.Error(err))
+	}
+	if err := container.GRPCServer.Shutdown(shutdownCtx); err != nil {
+		logger.Error("error al apagar servidor gRPC", zap.Error(err))
+	}
+}
+
+// runMigrate handles `cellcontrol migrate up|down|status` independently of
+// the server, so ops can run migrations without booting the full app.
+func runMigrate(args []string) {
+	if len(args) != 1 {
+		fmt.Fprintln(os.Stderr, "uso: cellcontrol migrate up|down|status")
+		os.Exit(1)
+	}
 
 	cfg := config.MustLoad()
-	dbConn := db.NewDatabase(cfg)
 
-	userRepo := repository.NewUserRepository(dbConn)
-	userSvc := service.NewUserService(userRepo)
-	userHandler := handlers.NewUserHandler(userSvc)
+	switch args[0] {
+	case "up":
+		if err := db.MigrateUp(cfg); err != nil {
+			fmt.Fprintf(os.Stderr, "error al migrar hacia arriba: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Println("migraciones aplicadas correctamente")
+	case "down":
+		if err := db.MigrateDown(cfg); err != nil {
+			fmt.Fprintf(os.Stderr, "error al revertir migraciones: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Println("migraciones revertidas correctamente")
+	case "status":
+		version, dirty, err := db.MigrateStatus(cfg)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error al obtener el estado de las migraciones: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("version=%d dirty=%t\n", version, dirty)
+	default:
+		fmt.Fprintln(os.Stderr, "uso: cellcontrol migrate up|down|status")
+		os.Exit(1)
+	}
+}
 
-	server := httpServer.NewServer(cfg, userHandler)
+// runCreateAdmin seeds an admin user directly against the database. It
+// exists because POST /usuarios requires an admin caller (internal/http/server.go),
+// so without this there would be no way to create the first one.
+func runCreateAdmin(args []string) {
+	if len(args) != 5 {
+		fmt.Fprintln(os.Stderr, "uso: cellcontrol create-admin <nombre> <apellido> <email> <reparto> <password>")
+		os.Exit(1)
+	}
+	nombre, apellido, email, reparto, password := args[0], args[1], args[2], args[3], args[4]
 
-	if err := server.Run(); err != nil {
-		logger.Error("error al iniciar servidor: %v", err)
+	cfg := config.MustLoad()
+	dbConn := db.NewDatabase(cfg)
+	repo := repository.NewUserRepository(dbConn)
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error al generar el hash de la contrasena: %v\n", err)
+		os.Exit(1)
+	}
+
+	user := &domain.User{
+		Nombre:       strings.TrimSpace(nombre),
+		Apellido:     strings.TrimSpace(apellido),
+		Email:        strings.ToLower(strings.TrimSpace(email)),
+		Reparto:      strings.TrimSpace(reparto),
+		PasswordHash: string(hash),
+		Role:         domain.RoleAdmin,
+	}
+	if err := repo.CreateUser(context.Background(), user); err != nil {
+		fmt.Fprintf(os.Stderr, "error al crear el administrador: %v\n", err)
+		os.Exit(1)
 	}
+	fmt.Println("administrador creado correctamente")
 }
@@ -1,34 +1,140 @@
 package config
 
 import (
-	"log"
+	"errors"
+	"fmt"
 	"os"
+	"strings"
+	"time"
 
-	"github.com/joho/godotenv"
+	"github.com/go-playground/validator/v10"
+	"github.com/spf13/viper"
 )
 
 type Config struct {
-	Env         string
-	HttpPort    string
-	DatabaseDSN string
+	Env             string         `mapstructure:"env" validate:"required,oneof=dev staging prod"`
+	Server          ServerConfig   `mapstructure:"server" validate:"required"`
+	Database        DatabaseConfig `mapstructure:"database" validate:"required"`
+	Log             LogConfig      `mapstructure:"log" validate:"required"`
+	JWT             JWTConfig      `mapstructure:"jwt" validate:"required"`
+	Metrics         MetricsConfig  `mapstructure:"metrics"`
+	GRPC            GRPCConfig     `mapstructure:"grpc"`
+	ShutdownTimeout time.Duration  `mapstructure:"shutdown_timeout" validate:"required"`
 }
 
-func Load() *Config {
-	_ = godotenv.Load()
-	return &Config{
-		Env:         getEnv("APP_ENV", "dev"),
-		HttpPort:    getEnv("HTTP_PORT", "8080"),
-		DatabaseDSN: getEnv("DATABASE_DSN", "user:password@tcp(localhost:3306)/dbname"),
+type ServerConfig struct {
+	Port         string        `mapstructure:"port" validate:"required"`
+	ReadTimeout  time.Duration `mapstructure:"read_timeout"`
+	WriteTimeout time.Duration `mapstructure:"write_timeout"`
+	IdleTimeout  time.Duration `mapstructure:"idle_timeout"`
+}
+
+type DatabaseConfig struct {
+	Driver          string        `mapstructure:"driver" validate:"required,oneof=sqlite mysql postgres"`
+	DSN             string        `mapstructure:"dsn" validate:"required"`
+	MaxOpenConns    int           `mapstructure:"max_open_conns"`
+	MaxIdleConns    int           `mapstructure:"max_idle_conns"`
+	ConnMaxLifetime time.Duration `mapstructure:"conn_max_lifetime"`
+}
+
+type LogConfig struct {
+	Level  string `mapstructure:"level" validate:"required,oneof=debug info warn error"`
+	Format string `mapstructure:"format" validate:"required,oneof=json console"`
+}
+
+type JWTConfig struct {
+	Secret     string        `mapstructure:"secret" validate:"required"`
+	AccessTTL  time.Duration `mapstructure:"access_ttl" validate:"required"`
+	RefreshTTL time.Duration `mapstructure:"refresh_ttl" validate:"required"`
+}
+
+type MetricsConfig struct {
+	Enabled bool   `mapstructure:"enabled"`
+	Path    string `mapstructure:"path"`
+}
+
+type GRPCConfig struct {
+	Port       string `mapstructure:"port"`
+	Reflection bool   `mapstructure:"reflection"`
+}
+
+// Load reads config.yaml (path from CONFIG_PATH, default ./config.yaml),
+// overlays the per-environment file for APP_ENV (config.<env>.yaml) if
+// present, then overlays environment variables, and returns the result
+// without validating it.
+func Load() (*Config, error) {
+	v := viper.New()
+	setDefaults(v)
+
+	v.SetEnvPrefix("")
+	v.AutomaticEnv()
+	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+
+	configPath := envOr("CONFIG_PATH", "./config.yaml")
+	v.SetConfigFile(configPath)
+	if err := v.ReadInConfig(); err != nil {
+		var notFound viper.ConfigFileNotFoundError
+		if !errors.As(err, &notFound) && !os.IsNotExist(err) {
+			return nil, fmt.Errorf("no se pudo leer %s: %w", configPath, err)
+		}
+	}
+
+	env := envOr("APP_ENV", v.GetString("env"))
+	if env != "" {
+		overlay := viper.New()
+		overlay.SetConfigFile(fmt.Sprintf("config.%s.yaml", env))
+		if err := overlay.ReadInConfig(); err == nil {
+			if err := v.MergeConfigMap(overlay.AllSettings()); err != nil {
+				return nil, fmt.Errorf("no se pudo aplicar overlay de %s: %w", env, err)
+			}
+		}
+	}
+
+	var cfg Config
+	if err := v.Unmarshal(&cfg); err != nil {
+		return nil, fmt.Errorf("no se pudo decodificar la configuracion: %w", err)
 	}
+	return &cfg, nil
 }
 
+// MustLoad loads and validates the configuration, failing fast with an
+// aggregated error instead of starting the app with a broken config.
 func MustLoad() *Config {
-	cfg := Load()
-	log.Printf("[config] env=%s port=%s db=%s\n", cfg.Env, cfg.HttpPort, cfg.DatabaseDSN)
+	cfg, err := Load()
+	if err != nil {
+		panic(fmt.Errorf("error al cargar la configuracion: %w", err))
+	}
+
+	if err := validator.New().Struct(cfg); err != nil {
+		panic(fmt.Errorf("configuracion invalida: %w", err))
+	}
 	return cfg
 }
 
-func getEnv(key, def string) string {
+func setDefaults(v *viper.Viper) {
+	v.SetDefault("env", "dev")
+	v.SetDefault("server.port", "8080")
+	v.SetDefault("server.read_timeout", 5*time.Second)
+	v.SetDefault("server.write_timeout", 10*time.Second)
+	v.SetDefault("server.idle_timeout", 60*time.Second)
+	v.SetDefault("database.driver", "sqlite")
+	v.SetDefault("database.dsn", "cellcontrol.db")
+	v.SetDefault("database.max_open_conns", 10)
+	v.SetDefault("database.max_idle_conns", 5)
+	v.SetDefault("database.conn_max_lifetime", time.Hour)
+	v.SetDefault("log.level", "info")
+	v.SetDefault("log.format", "console")
+	v.SetDefault("jwt.secret", "change-me-in-prod")
+	v.SetDefault("jwt.access_ttl", 15*time.Minute)
+	v.SetDefault("jwt.refresh_ttl", 7*24*time.Hour)
+	v.SetDefault("metrics.enabled", true)
+	v.SetDefault("metrics.path", "/metrics")
+	v.SetDefault("grpc.port", "9090")
+	v.SetDefault("grpc.reflection", false)
+	v.SetDefault("shutdown_timeout", 10*time.Second)
+}
+
+func envOr(key, def string) string {
 	if val, ok := os.LookupEnv(key); ok {
 		return val
 	}
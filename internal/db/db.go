@@ -1,24 +1,73 @@
 package db
 
 import (
+	"context"
 	"fmt"
+	"time"
+
 	"github.com/gonzalo-wi/cellcontrol/internal/config"
-	"github.com/gonzalo-wi/cellcontrol/internal/domain"
 	"github.com/gonzalo-wi/cellcontrol/pkg/logger"
+	"go.uber.org/zap"
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 )
 
+// NewDatabase opens a connection using the configured driver, applies the
+// connection-pool settings and pings the database before returning.
+// It does not change the schema: that is done by MigrateUp, which di.New
+// runs at startup and the `migrate` CLI subcommand runs on demand.
 func NewDatabase(cfg *config.Config) *gorm.DB {
-	db, err := gorm.Open(sqlite.Open(cfg.DatabaseDSN), &gorm.Config{})
+	dialector, err := dialectorFor(cfg.Database)
+	if err != nil {
+		panic(err)
+	}
+
+	db, err := gorm.Open(dialector, &gorm.Config{})
 	if err != nil {
-		logger.Error("error al conectar la Base de Datos: %v", err)
+		logger.Error("error al conectar la Base de Datos", zap.Error(err))
 		panic(fmt.Errorf("no se pudo conectar a la Base de Datos: %w", err))
 	}
-	if err := db.AutoMigrate(&domain.User{}); err != nil {
-		logger.Error("error al migrar la Base de Datos: %v", err)
-		panic(fmt.Errorf("no se pudo migrar la Base de Datos: %w", err))
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		panic(fmt.Errorf("no se pudo obtener el *sql.DB subyacente: %w", err))
+	}
+	sqlDB.SetMaxOpenConns(cfg.Database.MaxOpenConns)
+	sqlDB.SetMaxIdleConns(cfg.Database.MaxIdleConns)
+	sqlDB.SetConnMaxLifetime(cfg.Database.ConnMaxLifetime)
+
+	pingCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := sqlDB.PingContext(pingCtx); err != nil {
+		logger.Error("error al verificar la conexion a la Base de Datos", zap.Error(err))
+		panic(fmt.Errorf("no se pudo verificar la conexion a la Base de Datos: %w", err))
 	}
-	logger.Info("Base de datos inicializada correctamente")
+
+	logger.Info("Base de datos inicializada correctamente", zap.String("driver", cfg.Database.Driver))
 	return db
 }
+
+func dialectorFor(cfg config.DatabaseConfig) (gorm.Dialector, error) {
+	switch cfg.Driver {
+	case "sqlite":
+		return sqlite.Open(cfg.DSN), nil
+	case "mysql":
+		return mysql.Open(cfg.DSN), nil
+	case "postgres":
+		return postgres.Open(cfg.DSN), nil
+	default:
+		return nil, fmt.Errorf("driver de base de datos desconocido: %q", cfg.Driver)
+	}
+}
+
+// HealthCheck reports whether the database is reachable, used by the
+// /health endpoint instead of a hard-coded "ok".
+func HealthCheck(ctx context.Context, db *gorm.DB) error {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.PingContext(ctx)
+}
@@ -0,0 +1,104 @@
+package db
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+
+	"github.com/golang-migrate/migrate/v4"
+	_ "github.com/golang-migrate/migrate/v4/database/mysql"
+	_ "github.com/golang-migrate/migrate/v4/database/postgres"
+	_ "github.com/golang-migrate/migrate/v4/database/sqlite3"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+	"github.com/gonzalo-wi/cellcontrol/internal/config"
+)
+
+// migrations are kept one directory per dialect, since the sqlite/mysql/postgres
+// DDL for the same table is not interchangeable (auto-increment syntax, column
+// types, etc.).
+//
+//go:embed migrations/sqlite/*.sql migrations/mysql/*.sql migrations/postgres/*.sql
+var migrationsFS embed.FS
+
+func newMigrator(cfg *config.Config) (*migrate.Migrate, error) {
+	dir, err := migrationsDir(cfg.Database.Driver)
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := iofs.New(migrationsFS, dir)
+	if err != nil {
+		return nil, fmt.Errorf("no se pudieron cargar las migraciones embebidas: %w", err)
+	}
+
+	driverURL, err := migrationDriverURL(cfg.Database)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := migrate.NewWithSourceInstance("iofs", source, driverURL)
+	if err != nil {
+		return nil, fmt.Errorf("no se pudo inicializar el migrador: %w", err)
+	}
+	return m, nil
+}
+
+func migrationsDir(driver string) (string, error) {
+	switch driver {
+	case "sqlite", "mysql", "postgres":
+		return "migrations/" + driver, nil
+	default:
+		return "", fmt.Errorf("driver de base de datos desconocido: %q", driver)
+	}
+}
+
+func migrationDriverURL(cfg config.DatabaseConfig) (string, error) {
+	switch cfg.Driver {
+	case "sqlite":
+		return "sqlite3://" + cfg.DSN, nil
+	case "mysql":
+		return "mysql://" + cfg.DSN, nil
+	case "postgres":
+		return "postgres://" + cfg.DSN, nil
+	default:
+		return "", fmt.Errorf("driver de base de datos desconocido: %q", cfg.Driver)
+	}
+}
+
+// MigrateUp applies every pending migration.
+func MigrateUp(cfg *config.Config) error {
+	m, err := newMigrator(cfg)
+	if err != nil {
+		return err
+	}
+	if err := m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return err
+	}
+	return nil
+}
+
+// MigrateDown rolls back every applied migration.
+func MigrateDown(cfg *config.Config) error {
+	m, err := newMigrator(cfg)
+	if err != nil {
+		return err
+	}
+	if err := m.Down(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return err
+	}
+	return nil
+}
+
+// MigrateStatus returns the currently applied migration version and
+// whether the database is in a dirty (partially applied) state.
+func MigrateStatus(cfg *config.Config) (version uint, dirty bool, err error) {
+	m, err := newMigrator(cfg)
+	if err != nil {
+		return 0, false, err
+	}
+	version, dirty, err = m.Version()
+	if errors.Is(err, migrate.ErrNilVersion) {
+		return 0, false, nil
+	}
+	return version, dirty, err
+}
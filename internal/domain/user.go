@@ -2,12 +2,20 @@ package domain
 
 import "time"
 
+const (
+	RoleAdmin  = "admin"
+	RoleDriver = "driver"
+	RoleViewer = "viewer"
+)
+
 type User struct {
-	ID        uint      `gorm:"primaryKey;autoIncrement" json:"id"`
-	Nombre    string    `json:"nombre"`
-	Apellido  string    `json:"apellido"`
-	Email     string    `gorm:"uniqueIndex" json:"email"`
-	Reparto   string    `json:"reparto"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID           uint      `gorm:"primaryKey;autoIncrement" json:"id"`
+	Nombre       string    `json:"nombre"`
+	Apellido     string    `json:"apellido"`
+	Email        string    `gorm:"uniqueIndex" json:"email"`
+	Reparto      string    `json:"reparto"`
+	PasswordHash string    `json:"-"`
+	Role         string    `gorm:"default:viewer" json:"role"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
 }
@@ -0,0 +1,97 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/gonzalo-wi/cellcontrol/internal/domain"
+	userv1 "github.com/gonzalo-wi/cellcontrol/pkg/gen/user/v1"
+	"github.com/gonzalo-wi/cellcontrol/internal/service"
+	"github.com/gonzalo-wi/cellcontrol/pkg/logger"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// loggingInterceptor logs every RPC through the shared zap logger with its
+// full method name, latency and, on failure, the error. No request ID is
+// attached.
+func loggingInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+
+		fields := []zap.Field{
+			zap.String("method", info.FullMethod),
+			zap.Duration("latency", time.Since(start)),
+		}
+		if err != nil {
+			fields = append(fields, zap.Error(err))
+			logger.Error("grpc request", fields...)
+		} else {
+			logger.Info("grpc request", fields...)
+		}
+		return resp, err
+	}
+}
+
+// authInterceptor enforces the same JWT policy as the HTTP middleware,
+// reading the token from the "authorization" metadata entry.
+func authInterceptor(authSvc service.AuthService) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			return nil, status.Error(codes.Unauthenticated, "falta metadata de autorizacion")
+		}
+
+		values := md.Get("authorization")
+		if len(values) == 0 {
+			return nil, status.Error(codes.Unauthenticated, "falta token de autorizacion")
+		}
+
+		token := values[0]
+		const prefix = "Bearer "
+		if len(token) > len(prefix) && token[:len(prefix)] == prefix {
+			token = token[len(prefix):]
+		}
+
+		claims, err := authSvc.ParseAccessToken(ctx, token)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+
+		ctx = context.WithValue(ctx, claimsContextKey{}, claims)
+		return handler(ctx, req)
+	}
+}
+
+type claimsContextKey struct{}
+
+// requiredRoles maps a gRPC full method to the role it requires, mirroring
+// the per-route middleware.RequireRole gating on the HTTP side (see
+// internal/http/server.go) so both transports enforce identical policy.
+var requiredRoles = map[string]string{
+	userv1.UserService_CreateUser_FullMethodName: domain.RoleAdmin,
+}
+
+// roleInterceptor enforces requiredRoles. It must run after authInterceptor,
+// which populates claimsContextKey.
+func roleInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		role, gated := requiredRoles[info.FullMethod]
+		if !gated {
+			return handler(ctx, req)
+		}
+
+		claims, ok := ctx.Value(claimsContextKey{}).(*service.Claims)
+		if !ok {
+			return nil, status.Error(codes.Unauthenticated, "no autenticado")
+		}
+		if claims.Role != role {
+			return nil, status.Error(codes.PermissionDenied, "no tiene permisos suficientes")
+		}
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,65 @@
+package grpc
+
+import (
+	"context"
+	"net"
+
+	"github.com/gonzalo-wi/cellcontrol/internal/config"
+	"github.com/gonzalo-wi/cellcontrol/internal/service"
+	"github.com/gonzalo-wi/cellcontrol/pkg/logger"
+	userv1 "github.com/gonzalo-wi/cellcontrol/pkg/gen/user/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+	"go.uber.org/zap"
+)
+
+type Server struct {
+	grpcSrv *grpc.Server
+	cfg     *config.Config
+}
+
+// NewServer registers a UserServiceServer backed by the existing
+// service.UserService, so REST and gRPC share identical business logic.
+func NewServer(cfg *config.Config, userSvc service.UserService, authSvc service.AuthService) *Server {
+	srv := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			loggingInterceptor(),
+			authInterceptor(authSvc),
+			roleInterceptor(),
+		),
+	)
+
+	userv1.RegisterUserServiceServer(srv, newUserServiceServer(userSvc))
+
+	if cfg.GRPC.Reflection {
+		reflection.Register(srv)
+	}
+
+	return &Server{grpcSrv: srv, cfg: cfg}
+}
+
+func (s *Server) Start() error {
+	lis, err := net.Listen("tcp", ":"+s.cfg.GRPC.Port)
+	if err != nil {
+		return err
+	}
+	logger.Info("escuchando gRPC", zap.String("addr", lis.Addr().String()))
+	return s.grpcSrv.Serve(lis)
+}
+
+func (s *Server) Shutdown(ctx context.Context) error {
+	logger.Info("apagando servidor gRPC")
+	stopped := make(chan struct{})
+	go func() {
+		s.grpcSrv.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		s.grpcSrv.Stop()
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,57 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/gonzalo-wi/cellcontrol/internal/domain"
+	userv1 "github.com/gonzalo-wi/cellcontrol/pkg/gen/user/v1"
+	"github.com/gonzalo-wi/cellcontrol/internal/service"
+)
+
+type userServiceServer struct {
+	userv1.UnimplementedUserServiceServer
+	svc service.UserService
+}
+
+func newUserServiceServer(svc service.UserService) *userServiceServer {
+	return &userServiceServer{svc: svc}
+}
+
+func (s *userServiceServer) CreateUser(ctx context.Context, req *userv1.CreateUserRequest) (*userv1.CreateUserResponse, error) {
+	if err := s.svc.CreateUser(ctx, req.GetNombre(), req.GetApellido(), req.GetEmail(), req.GetReparto(), req.GetPassword()); err != nil {
+		return nil, err
+	}
+	return &userv1.CreateUserResponse{Message: "usuario creado exitosamente"}, nil
+}
+
+func (s *userServiceServer) ListUsers(ctx context.Context, _ *userv1.ListUsersRequest) (*userv1.ListUsersResponse, error) {
+	users, err := s.svc.GetAllUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &userv1.ListUsersResponse{Users: make([]*userv1.User, 0, len(users))}
+	for _, u := range users {
+		resp.Users = append(resp.Users, toProto(&u))
+	}
+	return resp, nil
+}
+
+func (s *userServiceServer) GetUser(ctx context.Context, req *userv1.GetUserRequest) (*userv1.User, error) {
+	user, err := s.svc.GetUser(ctx, uint(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+	return toProto(user), nil
+}
+
+func toProto(u *domain.User) *userv1.User {
+	return &userv1.User{
+		Id:       uint32(u.ID),
+		Nombre:   u.Nombre,
+		Apellido: u.Apellido,
+		Email:    u.Email,
+		Reparto:  u.Reparto,
+		Role:     u.Role,
+	}
+}
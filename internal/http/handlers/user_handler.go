@@ -20,11 +20,7 @@ type createUserRequest struct {
 	Apellido string `json:"apellido" binding:"required"`
 	Email    string `json:"email" binding:"required,email"`
 	Reparto  string `json:"reparto" binding:"required"`
-}
-
-func (h *UserHandler) RegisterRoutes(r *gin.RouterGroup) {
-	r.POST("/usuarios", h.CreateUser)
-	r.GET("/usuarios", h.ListUsers)
+	Password string `json:"password" binding:"required,min=8"`
 }
 
 func (h *UserHandler) CreateUser(c *gin.Context) {
@@ -34,7 +30,7 @@ func (h *UserHandler) CreateUser(c *gin.Context) {
 		return
 	}
 
-	err := h.svc.CreateUser(req.Nombre, req.Apellido, req.Email, req.Reparto)
+	err := h.svc.CreateUser(c.Request.Context(), req.Nombre, req.Apellido, req.Email, req.Reparto, req.Password)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "no se pudo crear el usuario"})
 		return
@@ -44,7 +40,7 @@ func (h *UserHandler) CreateUser(c *gin.Context) {
 }
 
 func (h *UserHandler) ListUsers(c *gin.Context) {
-	users, err := h.svc.GetAllUsers()
+	users, err := h.svc.GetAllUsers(c.Request.Context())
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "no se pudo obtener usuarios"})
 		return
@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gonzalo-wi/cellcontrol/internal/service"
+)
+
+const ClaimsContextKey = "claims"
+
+func AuthRequired(authSvc service.AuthService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		header := c.GetHeader("Authorization")
+		parts := strings.SplitN(header, " ", 2)
+		if len(parts) != 2 || parts[0] != "Bearer" {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "falta token de autorizacion"})
+			return
+		}
+
+		claims, err := authSvc.ParseAccessToken(c.Request.Context(), parts[1])
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.Set(ClaimsContextKey, claims)
+		c.Next()
+	}
+}
+
+func RequireRole(role string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		raw, ok := c.Get(ClaimsContextKey)
+		if !ok {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "no autenticado"})
+			return
+		}
+
+		claims, ok := raw.(*service.Claims)
+		if !ok || claims.Role != role {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "no tiene permisos suficientes"})
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,62 @@
+package middleware
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/gonzalo-wi/cellcontrol/pkg/logger"
+	"go.uber.org/zap"
+)
+
+const (
+	RequestIDHeader     = "X-Request-ID"
+	RequestIDContextKey = "request_id"
+	LoggerContextKey    = "logger"
+)
+
+// RequestLogger takes the request ID from the X-Request-ID header, or
+// generates one when the header is absent, attaches a child logger to the
+// gin.Context so handlers/services can log with correlation, and logs a
+// summary line once the request finishes.
+func RequestLogger() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+
+		requestID := c.GetHeader(RequestIDHeader)
+		if requestID == "" {
+			requestID = uuid.NewString()
+		}
+
+		reqLogger := logger.With(zap.String("request_id", requestID))
+		c.Set(RequestIDContextKey, requestID)
+		c.Set(LoggerContextKey, reqLogger)
+		c.Header(RequestIDHeader, requestID)
+
+		// Carry the request-scoped logger on the stdlib context too, so it
+		// survives the trip through the service/repository layers, which
+		// know nothing about gin.Context.
+		c.Request = c.Request.WithContext(logger.WithContext(c.Request.Context(), reqLogger))
+
+		c.Next()
+
+		reqLogger.Info("request",
+			zap.String("method", c.Request.Method),
+			zap.String("path", c.FullPath()),
+			zap.Int("status", c.Writer.Status()),
+			zap.Duration("latency", time.Since(start)),
+			zap.String("client_ip", c.ClientIP()),
+		)
+	}
+}
+
+// FromContext returns the request-scoped logger, falling back to the
+// global logger if none was set (e.g. outside an HTTP request).
+func FromContext(c *gin.Context) *zap.Logger {
+	if raw, ok := c.Get(LoggerContextKey); ok {
+		if l, ok := raw.(*zap.Logger); ok {
+			return l
+		}
+	}
+	return logger.With()
+}
@@ -1,37 +1,77 @@
 package http
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	nethttp "net/http"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gonzalo-wi/cellcontrol/internal/config"
+	"github.com/gonzalo-wi/cellcontrol/internal/db"
+	"github.com/gonzalo-wi/cellcontrol/internal/domain"
 	"github.com/gonzalo-wi/cellcontrol/internal/http/handlers"
+	"github.com/gonzalo-wi/cellcontrol/internal/http/middleware"
+	"github.com/gonzalo-wi/cellcontrol/internal/service"
 	"github.com/gonzalo-wi/cellcontrol/pkg/logger"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
 )
 
 type Server struct {
 	engine *gin.Engine
+	srv    *nethttp.Server
 	cfg    *config.Config
 }
 
-func NewServer(cfg *config.Config, userHandler *handlers.UserHandler) *Server {
-	r := gin.Default()
+func NewServer(cfg *config.Config, dbConn *gorm.DB, userHandler *handlers.UserHandler, authHandler *handlers.AuthHandler, authSvc service.AuthService) *Server {
+	r := gin.New()
+	r.Use(gin.Recovery(), middleware.RequestLogger(), middleware.Metrics())
 
 	r.GET("/health", func(c *gin.Context) {
-		c.JSON(200, gin.H{"status": "ok"})
+		if err := db.HealthCheck(c.Request.Context(), dbConn); err != nil {
+			c.JSON(nethttp.StatusServiceUnavailable, gin.H{"status": "error", "error": err.Error()})
+			return
+		}
+		c.JSON(nethttp.StatusOK, gin.H{"status": "ok"})
 	})
 
+	if cfg.Metrics.Enabled {
+		r.GET(cfg.Metrics.Path, gin.WrapH(promhttp.Handler()))
+	}
+
 	api := r.Group("/api/v1")
-	userHandler.RegisterRoutes(api)
+	authHandler.RegisterRoutes(api)
+
+	usuarios := api.Group("/")
+	usuarios.Use(middleware.AuthRequired(authSvc))
+	usuarios.GET("/usuarios", userHandler.ListUsers)
+	usuarios.POST("/usuarios", middleware.RequireRole(domain.RoleAdmin), userHandler.CreateUser)
 
 	return &Server{
 		engine: r,
 		cfg:    cfg,
+		srv: &nethttp.Server{
+			Addr:         fmt.Sprintf(":%s", cfg.Server.Port),
+			Handler:      r,
+			ReadTimeout:  cfg.Server.ReadTimeout,
+			WriteTimeout: cfg.Server.WriteTimeout,
+			IdleTimeout:  cfg.Server.IdleTimeout,
+		},
+	}
+}
+
+// Start blocks serving HTTP until the listener is closed by Shutdown.
+func (s *Server) Start(ctx context.Context) error {
+	logger.Info("escuchando", zap.String("addr", s.srv.Addr))
+	if err := s.srv.ListenAndServe(); err != nil && !errors.Is(err, nethttp.ErrServerClosed) {
+		return err
 	}
+	return nil
 }
 
-func (s *Server) Run() error {
-	addr := fmt.Sprintf(":%s", s.cfg.HttpPort)
-	logger.Info("escuchando en %s", addr)
-	return s.engine.Run(addr)
+func (s *Server) Shutdown(ctx context.Context) error {
+	logger.Info("apagando servidor HTTP")
+	return s.srv.Shutdown(ctx)
 }
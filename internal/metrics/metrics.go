@@ -0,0 +1,43 @@
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	HTTPRequestsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "http_requests_total",
+			Help: "Total de requests HTTP procesados, por metodo, ruta y status.",
+		},
+		[]string{"method", "path", "status"},
+	)
+
+	HTTPRequestDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "http_request_duration_seconds",
+			Help:    "Duracion de los requests HTTP en segundos, por metodo y ruta.",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"method", "path"},
+	)
+
+	DBOperationsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "db_operations_total",
+			Help: "Total de operaciones contra la base de datos, por operacion, tabla y resultado.",
+		},
+		[]string{"op", "table", "result"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(HTTPRequestsTotal, HTTPRequestDuration, DBOperationsTotal)
+}
+
+// ObserveDBOp increments db_operations_total for a single repository call.
+func ObserveDBOp(op, table string, err error) {
+	result := "success"
+	if err != nil {
+		result = "error"
+	}
+	DBOperationsTotal.WithLabelValues(op, table, result).Inc()
+}
@@ -1,13 +1,22 @@
 package repository
 
 import (
+	"context"
+
 	"github.com/gonzalo-wi/cellcontrol/internal/domain"
+	"github.com/gonzalo-wi/cellcontrol/internal/metrics"
+	"github.com/gonzalo-wi/cellcontrol/pkg/logger"
+	"go.uber.org/zap"
 	"gorm.io/gorm"
 )
 
+const usersTable = "users"
+
 type UserRepository interface {
-	CreateUser(user *domain.User) error
-	GetAllUsers() ([]domain.User, error)
+	CreateUser(ctx context.Context, user *domain.User) error
+	GetAllUsers(ctx context.Context) ([]domain.User, error)
+	GetByEmail(ctx context.Context, email string) (*domain.User, error)
+	GetByID(ctx context.Context, id uint) (*domain.User, error)
 }
 
 type userRepository struct {
@@ -18,12 +27,43 @@ func NewUserRepository(db *gorm.DB) UserRepository {
 	return &userRepository{db: db}
 }
 
-func (r *userRepository) CreateUser(user *domain.User) error {
-	return r.db.Create(user).Error
+func (r *userRepository) CreateUser(ctx context.Context, user *domain.User) error {
+	err := r.db.WithContext(ctx).Create(user).Error
+	metrics.ObserveDBOp("create", usersTable, err)
+	if err != nil {
+		logger.FromContext(ctx).Error("create user", zap.Error(err))
+	}
+	return err
 }
 
-func (r *userRepository) GetAllUsers() ([]domain.User, error) {
+func (r *userRepository) GetAllUsers(ctx context.Context) ([]domain.User, error) {
 	var users []domain.User
-	err := r.db.Find(&users).Error
+	err := r.db.WithContext(ctx).Find(&users).Error
+	metrics.ObserveDBOp("select", usersTable, err)
+	if err != nil {
+		logger.FromContext(ctx).Error("list users", zap.Error(err))
+	}
 	return users, err
 }
+
+func (r *userRepository) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
+	var user domain.User
+	err := r.db.WithContext(ctx).Where("email = ?", email).First(&user).Error
+	metrics.ObserveDBOp("select", usersTable, err)
+	if err != nil {
+		logger.FromContext(ctx).Warn("get user by email", zap.Error(err))
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (r *userRepository) GetByID(ctx context.Context, id uint) (*domain.User, error) {
+	var user domain.User
+	err := r.db.WithContext(ctx).First(&user, id).Error
+	metrics.ObserveDBOp("select", usersTable, err)
+	if err != nil {
+		logger.FromContext(ctx).Warn("get user by id", zap.Error(err))
+		return nil, err
+	}
+	return &user, nil
+}
@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/gonzalo-wi/cellcontrol/internal/config"
+	"github.com/gonzalo-wi/cellcontrol/internal/repository"
+	"github.com/gonzalo-wi/cellcontrol/pkg/logger"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var ErrInvalidCredentials = errors.New("credenciales invalidas")
+
+const (
+	tokenTypeAccess  = "access"
+	tokenTypeRefresh = "refresh"
+)
+
+type Claims struct {
+	UserID    uint   `json:"user_id"`
+	Email     string `json:"email"`
+	Role      string `json:"role"`
+	TokenType string `json:"typ"`
+	jwt.RegisteredClaims
+}
+
+type TokenPair struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+type AuthService interface {
+	Authenticate(ctx context.Context, email, password string) (*TokenPair, error)
+	Refresh(ctx context.Context, refreshToken string) (*TokenPair, error)
+	ParseAccessToken(ctx context.Context, tokenStr string) (*Claims, error)
+}
+
+type authService struct {
+	repo repository.UserRepository
+	cfg  *config.Config
+}
+
+func NewAuthService(repo repository.UserRepository, cfg *config.Config) AuthService {
+	return &authService{repo: repo, cfg: cfg}
+}
+
+func (s *authService) Authenticate(ctx context.Context, email, password string) (*TokenPair, error) {
+	user, err := s.repo.GetByEmail(ctx, email)
+	if err != nil {
+		return nil, ErrInvalidCredentials
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)); err != nil {
+		logger.FromContext(ctx).Warn("login fallido", zap.String("email", email))
+		return nil, ErrInvalidCredentials
+	}
+
+	return s.issueTokenPair(user.ID, user.Email, user.Role)
+}
+
+func (s *authService) Refresh(ctx context.Context, refreshToken string) (*TokenPair, error) {
+	claims, err := s.parseToken(refreshToken, tokenTypeRefresh)
+	if err != nil {
+		return nil, err
+	}
+	return s.issueTokenPair(claims.UserID, claims.Email, claims.Role)
+}
+
+// ParseAccessToken validates an access token and rejects a well-formed
+// refresh token presented in its place.
+func (s *authService) ParseAccessToken(ctx context.Context, tokenStr string) (*Claims, error) {
+	return s.parseToken(tokenStr, tokenTypeAccess)
+}
+
+func (s *authService) parseToken(tokenStr, wantType string) (*Claims, error) {
+	claims := &Claims{}
+	token, err := jwt.ParseWithClaims(tokenStr, claims, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errors.New("metodo de firma inesperado")
+		}
+		return []byte(s.cfg.JWT.Secret), nil
+	})
+	if err != nil || !token.Valid {
+		return nil, errors.New("token invalido o expirado")
+	}
+	if claims.TokenType != wantType {
+		return nil, errors.New("tipo de token invalido")
+	}
+	return claims, nil
+}
+
+func (s *authService) issueTokenPair(userID uint, email, role string) (*TokenPair, error) {
+	access, err := s.signToken(userID, email, role, tokenTypeAccess, s.cfg.JWT.AccessTTL)
+	if err != nil {
+		return nil, err
+	}
+	refresh, err := s.signToken(userID, email, role, tokenTypeRefresh, s.cfg.JWT.RefreshTTL)
+	if err != nil {
+		return nil, err
+	}
+	return &TokenPair{AccessToken: access, RefreshToken: refresh}, nil
+}
+
+func (s *authService) signToken(userID uint, email, role, tokenType string, ttl time.Duration) (string, error) {
+	claims := Claims{
+		UserID:    userID,
+		Email:     email,
+		Role:      role,
+		TokenType: tokenType,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(ttl)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		},
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString([]byte(s.cfg.JWT.Secret))
+}
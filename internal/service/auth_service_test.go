@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gonzalo-wi/cellcontrol/internal/config"
+	"github.com/gonzalo-wi/cellcontrol/internal/domain"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var errUserNotFound = errors.New("user not found")
+
+type fakeUserRepository struct {
+	byEmail map[string]*domain.User
+	byID    map[uint]*domain.User
+}
+
+func newFakeUserRepository() *fakeUserRepository {
+	return &fakeUserRepository{byEmail: map[string]*domain.User{}, byID: map[uint]*domain.User{}}
+}
+
+func (r *fakeUserRepository) CreateUser(ctx context.Context, user *domain.User) error {
+	r.byEmail[user.Email] = user
+	r.byID[user.ID] = user
+	return nil
+}
+
+func (r *fakeUserRepository) GetAllUsers(ctx context.Context) ([]domain.User, error) {
+	users := make([]domain.User, 0, len(r.byID))
+	for _, u := range r.byID {
+		users = append(users, *u)
+	}
+	return users, nil
+}
+
+func (r *fakeUserRepository) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
+	user, ok := r.byEmail[email]
+	if !ok {
+		return nil, errUserNotFound
+	}
+	return user, nil
+}
+
+func (r *fakeUserRepository) GetByID(ctx context.Context, id uint) (*domain.User, error) {
+	user, ok := r.byID[id]
+	if !ok {
+		return nil, errUserNotFound
+	}
+	return user, nil
+}
+
+func newTestAuthService(t *testing.T) (AuthService, *fakeUserRepository) {
+	t.Helper()
+
+	repo := newFakeUserRepository()
+	hash, err := bcrypt.GenerateFromPassword([]byte("correcthorse"), bcrypt.DefaultCost)
+	if err != nil {
+		t.Fatalf("no se pudo generar el hash de prueba: %v", err)
+	}
+	if err := repo.CreateUser(context.Background(), &domain.User{
+		ID:           1,
+		Email:        "admin@example.com",
+		PasswordHash: string(hash),
+		Role:         domain.RoleAdmin,
+	}); err != nil {
+		t.Fatalf("no se pudo crear el usuario de prueba: %v", err)
+	}
+
+	cfg := &config.Config{
+		JWT: config.JWTConfig{
+			Secret:     "test-secret",
+			AccessTTL:  time.Minute,
+			RefreshTTL: time.Hour,
+		},
+	}
+	return NewAuthService(repo, cfg), repo
+}
+
+func TestAuthenticate_Success(t *testing.T) {
+	svc, _ := newTestAuthService(t)
+
+	tokens, err := svc.Authenticate(context.Background(), "admin@example.com", "correcthorse")
+	if err != nil {
+		t.Fatalf("esperaba login exitoso, obtuve: %v", err)
+	}
+
+	claims, err := svc.ParseAccessToken(context.Background(), tokens.AccessToken)
+	if err != nil {
+		t.Fatalf("el access token emitido deberia ser valido: %v", err)
+	}
+	if claims.Role != domain.RoleAdmin {
+		t.Errorf("role = %q, want %q", claims.Role, domain.RoleAdmin)
+	}
+}
+
+func TestAuthenticate_WrongPassword(t *testing.T) {
+	svc, _ := newTestAuthService(t)
+
+	if _, err := svc.Authenticate(context.Background(), "admin@example.com", "wrong-password"); err != ErrInvalidCredentials {
+		t.Fatalf("err = %v, want %v", err, ErrInvalidCredentials)
+	}
+}
+
+func TestAuthenticate_UnknownEmail(t *testing.T) {
+	svc, _ := newTestAuthService(t)
+
+	if _, err := svc.Authenticate(context.Background(), "nobody@example.com", "whatever"); err != ErrInvalidCredentials {
+		t.Fatalf("err = %v, want %v", err, ErrInvalidCredentials)
+	}
+}
+
+func TestRefresh_WithRefreshToken_Succeeds(t *testing.T) {
+	svc, _ := newTestAuthService(t)
+
+	tokens, err := svc.Authenticate(context.Background(), "admin@example.com", "correcthorse")
+	if err != nil {
+		t.Fatalf("login inesperado fallo: %v", err)
+	}
+
+	next, err := svc.Refresh(context.Background(), tokens.RefreshToken)
+	if err != nil {
+		t.Fatalf("esperaba refresh exitoso, obtuve: %v", err)
+	}
+	if next.AccessToken == "" || next.RefreshToken == "" {
+		t.Fatalf("refresh deberia emitir un nuevo par de tokens")
+	}
+}
+
+func TestRefresh_WithAccessToken_Rejected(t *testing.T) {
+	svc, _ := newTestAuthService(t)
+
+	tokens, err := svc.Authenticate(context.Background(), "admin@example.com", "correcthorse")
+	if err != nil {
+		t.Fatalf("login inesperado fallo: %v", err)
+	}
+
+	if _, err := svc.Refresh(context.Background(), tokens.AccessToken); err == nil {
+		t.Fatal("Refresh no deberia aceptar un access token")
+	}
+}
+
+func TestParseAccessToken_RejectsRefreshToken(t *testing.T) {
+	svc, _ := newTestAuthService(t)
+
+	tokens, err := svc.Authenticate(context.Background(), "admin@example.com", "correcthorse")
+	if err != nil {
+		t.Fatalf("login inesperado fallo: %v", err)
+	}
+
+	if _, err := svc.ParseAccessToken(context.Background(), tokens.RefreshToken); err == nil {
+		t.Fatal("ParseAccessToken no deberia aceptar un refresh token")
+	}
+}
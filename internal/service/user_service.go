@@ -1,15 +1,20 @@
 package service
 
 import (
+	"context"
 	"strings"
 
 	"github.com/gonzalo-wi/cellcontrol/internal/domain"
 	"github.com/gonzalo-wi/cellcontrol/internal/repository"
+	"github.com/gonzalo-wi/cellcontrol/pkg/logger"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
 )
 
 type UserService interface {
-	CreateUser(nombre, apellido, email, reparto string) error
-	GetAllUsers() ([]domain.User, error)
+	CreateUser(ctx context.Context, nombre, apellido, email, reparto, password string) error
+	GetAllUsers(ctx context.Context) ([]domain.User, error)
+	GetUser(ctx context.Context, id uint) (*domain.User, error)
 }
 
 type userService struct {
@@ -20,19 +25,31 @@ func NewUserService(repo repository.UserRepository) UserService {
 	return &userService{repo: repo}
 }
 
-func (s *userService) CreateUser(nombre, apellido, email, reparto string) error {
+func (s *userService) CreateUser(ctx context.Context, nombre, apellido, email, reparto, password string) error {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+
 	u := &domain.User{
-		Nombre:   strings.TrimSpace(nombre),
-		Apellido: strings.TrimSpace(apellido),
-		Email:    strings.ToLower(strings.TrimSpace(email)),
-		Reparto:  strings.TrimSpace(reparto),
+		Nombre:       strings.TrimSpace(nombre),
+		Apellido:     strings.TrimSpace(apellido),
+		Email:        strings.ToLower(strings.TrimSpace(email)),
+		Reparto:      strings.TrimSpace(reparto),
+		PasswordHash: string(hash),
+		Role:         domain.RoleViewer,
 	}
-	if err := s.repo.CreateUser(u); err != nil {
+	if err := s.repo.CreateUser(ctx, u); err != nil {
 		return err
 	}
+	logger.FromContext(ctx).Info("usuario creado", zap.String("email", u.Email))
 	return nil
 }
 
-func (s *userService) GetAllUsers() ([]domain.User, error) {
-	return s.repo.GetAllUsers()
+func (s *userService) GetAllUsers(ctx context.Context) ([]domain.User, error) {
+	return s.repo.GetAllUsers(ctx)
+}
+
+func (s *userService) GetUser(ctx context.Context, id uint) (*domain.User, error) {
+	return s.repo.GetByID(ctx, id)
 }
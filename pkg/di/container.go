@@ -0,0 +1,77 @@
+package di
+
+import (
+	"context"
+
+	"github.com/gonzalo-wi/cellcontrol/internal/config"
+	"github.com/gonzalo-wi/cellcontrol/internal/db"
+	grpcServer "github.com/gonzalo-wi/cellcontrol/internal/grpc"
+	httpServer "github.com/gonzalo-wi/cellcontrol/internal/http"
+	"github.com/gonzalo-wi/cellcontrol/internal/http/handlers"
+	"github.com/gonzalo-wi/cellcontrol/internal/repository"
+	"github.com/gonzalo-wi/cellcontrol/internal/service"
+	"github.com/gonzalo-wi/cellcontrol/pkg/logger"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// Container holds every layer of the application, built once at startup and
+// reused for the lifetime of the process (or a test run).
+type Container struct {
+	Config     *config.Config
+	Logger     *zap.Logger
+	DB         *gorm.DB
+	UserRepo   repository.UserRepository
+	UserSvc    service.UserService
+	AuthSvc    service.AuthService
+	Server     *httpServer.Server
+	GRPCServer *grpcServer.Server
+}
+
+// New builds the container layer by layer: config, logger, database (and
+// pending migrations), repositories, services, handlers and finally the
+// HTTP and gRPC servers.
+func New(ctx context.Context) (*Container, error) {
+	cfg := config.MustLoad()
+
+	logger.Init(cfg)
+	log := logger.With()
+
+	dbConn := db.NewDatabase(cfg)
+	if err := db.MigrateUp(cfg); err != nil {
+		return nil, err
+	}
+
+	userRepo := repository.NewUserRepository(dbConn)
+	userSvc := service.NewUserService(userRepo)
+	authSvc := service.NewAuthService(userRepo, cfg)
+
+	userHandler := handlers.NewUserHandler(userSvc)
+	authHandler := handlers.NewAuthHandler(authSvc)
+
+	server := httpServer.NewServer(cfg, dbConn, userHandler, authHandler, authSvc)
+	grpcSrv := grpcServer.NewServer(cfg, userSvc, authSvc)
+
+	return &Container{
+		Config:     cfg,
+		Logger:     log,
+		DB:         dbConn,
+		UserRepo:   userRepo,
+		UserSvc:    userSvc,
+		AuthSvc:    authSvc,
+		Server:     server,
+		GRPCServer: grpcSrv,
+	}, nil
+}
+
+// Close releases the database connection and flushes buffered logs. It
+// should be deferred right after a successful New.
+func (c *Container) Close() error {
+	defer logger.Sync()
+
+	sqlDB, err := c.DB.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.Close()
+}
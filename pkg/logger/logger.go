@@ -1,32 +1,73 @@
 package logger
 
 import (
-	"log"
 	"os"
-)
 
-var (
-	infoLogger  *log.Logger
-	errorLogger *log.Logger
+	"github.com/gonzalo-wi/cellcontrol/internal/config"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 )
 
-func Init() {
-	infoLogger = log.New(os.Stdout, "INFO: ", log.Ldate|log.Ltime|log.Lshortfile)
-	errorLogger = log.New(os.Stderr, "[ERROR] ", log.LstdFlags|log.Lshortfile)
+var log = zap.NewNop()
+
+// Init builds the global logger with two cores: a JSON core writing warn+
+// to stderr, and a level/format-configurable core writing info and below
+// to stdout.
+func Init(cfg *config.Config) {
+	level := parseLevel(cfg.Log.Level)
+
+	consoleEnc := jsonEncoder()
+	if cfg.Log.Format != "json" {
+		consoleEnc = consoleEncoder()
+	}
+
+	stderrCore := zapcore.NewCore(jsonEncoder(), zapcore.Lock(os.Stderr), highPriority)
+	stdoutCore := zapcore.NewCore(consoleEnc, zapcore.Lock(os.Stdout), lowPriority(level))
+
+	log = zap.New(zapcore.NewTee(stderrCore, stdoutCore), zap.AddCaller())
+}
+
+func With(fields ...zap.Field) *zap.Logger {
+	return log.With(fields...)
 }
 
-func Info(msg string, args ...any) {
-	if len(args) > 0 {
-		infoLogger.Printf(msg, args...)
-	} else {
-		infoLogger.Println(msg)
+func Debug(msg string, fields ...zap.Field) { log.Debug(msg, fields...) }
+func Info(msg string, fields ...zap.Field)  { log.Info(msg, fields...) }
+func Warn(msg string, fields ...zap.Field)  { log.Warn(msg, fields...) }
+func Error(msg string, fields ...zap.Field) { log.Error(msg, fields...) }
+func Fatal(msg string, fields ...zap.Field) { log.Fatal(msg, fields...) }
+
+func Sync() error {
+	return log.Sync()
+}
+
+func parseLevel(raw string) zapcore.Level {
+	var lvl zapcore.Level
+	if err := lvl.Set(raw); err != nil {
+		return zapcore.InfoLevel
 	}
+	return lvl
+}
+
+func jsonEncoder() zapcore.Encoder {
+	cfg := zap.NewProductionEncoderConfig()
+	cfg.TimeKey = "timestamp"
+	cfg.EncodeTime = zapcore.ISO8601TimeEncoder
+	return zapcore.NewJSONEncoder(cfg)
+}
+
+func consoleEncoder() zapcore.Encoder {
+	cfg := zap.NewDevelopmentEncoderConfig()
+	cfg.EncodeTime = zapcore.ISO8601TimeEncoder
+	return zapcore.NewConsoleEncoder(cfg)
 }
 
-func Error(msg string, args ...any) {
-	if len(args) > 0 {
-		errorLogger.Printf(msg, args...)
-	} else {
-		errorLogger.Println(msg)
+var highPriority = zap.LevelEnablerFunc(func(l zapcore.Level) bool {
+	return l >= zapcore.WarnLevel
+})
+
+func lowPriority(min zapcore.Level) zap.LevelEnablerFunc {
+	return func(l zapcore.Level) bool {
+		return l >= min && l < zapcore.WarnLevel
 	}
 }